@@ -0,0 +1,162 @@
+// Package acme implements a lego-compatible ACME DNS-01 challenge.Provider
+// for GoDaddy, built on top of this module's Domain().Records() API.
+package acme
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge/dns01"
+
+	godaddy "github.com/oze4/godaddygo"
+)
+
+// minTTL is the lowest TTL GoDaddy will accept for a record.
+const minTTL = 600
+
+const (
+	defaultPropagationTimeout = 30 * time.Minute
+	defaultPollingInterval    = 10 * time.Second
+)
+
+// Config holds the configuration used to build a DNSProvider.
+type Config struct {
+	APIKey    string
+	APISecret string
+
+	// TTL is the TTL, in seconds, used when creating the TXT record. It is
+	// clamped to minTTL because GoDaddy rejects lower values.
+	TTL int
+
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+}
+
+// NewDefaultConfig returns a Config populated with this provider's defaults.
+func NewDefaultConfig() *Config {
+	return &Config{
+		TTL:                minTTL,
+		PropagationTimeout: defaultPropagationTimeout,
+		PollingInterval:    defaultPollingInterval,
+	}
+}
+
+// DNSProvider implements challenge.Provider for the GoDaddy DNS API.
+type DNSProvider struct {
+	config  *Config
+	options *godaddy.Options
+}
+
+// NewDNSProvider returns a DNSProvider configured from the environment:
+// GODADDY_API_KEY, GODADDY_API_SECRET and, optionally, GODADDY_TTL,
+// GODADDY_PROPAGATION_TIMEOUT and GODADDY_POLLING_INTERVAL.
+func NewDNSProvider() (*DNSProvider, error) {
+	apiKey := os.Getenv("GODADDY_API_KEY")
+	apiSecret := os.Getenv("GODADDY_API_SECRET")
+	if apiKey == "" || apiSecret == "" {
+		return nil, errors.New("acme: GODADDY_API_KEY and GODADDY_API_SECRET must be set")
+	}
+
+	config := NewDefaultConfig()
+	config.APIKey = apiKey
+	config.APISecret = apiSecret
+
+	if ttl := os.Getenv("GODADDY_TTL"); ttl != "" {
+		v, err := strconv.Atoi(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("acme: invalid GODADDY_TTL: %w", err)
+		}
+		config.TTL = v
+	}
+
+	if timeout := os.Getenv("GODADDY_PROPAGATION_TIMEOUT"); timeout != "" {
+		v, err := strconv.Atoi(timeout)
+		if err != nil {
+			return nil, fmt.Errorf("acme: invalid GODADDY_PROPAGATION_TIMEOUT: %w", err)
+		}
+		config.PropagationTimeout = time.Duration(v) * time.Second
+	}
+
+	if interval := os.Getenv("GODADDY_POLLING_INTERVAL"); interval != "" {
+		v, err := strconv.Atoi(interval)
+		if err != nil {
+			return nil, fmt.Errorf("acme: invalid GODADDY_POLLING_INTERVAL: %w", err)
+		}
+		config.PollingInterval = time.Duration(v) * time.Second
+	}
+
+	return NewDNSProviderConfig(config)
+}
+
+// NewDNSProviderConfig returns a DNSProvider configured from config.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, errors.New("acme: the configuration of the GoDaddy DNS provider is nil")
+	}
+	if config.APIKey == "" || config.APISecret == "" {
+		return nil, errors.New("acme: GoDaddy API key and secret are required")
+	}
+	if config.TTL < minTTL {
+		config.TTL = minTTL
+	}
+
+	return &DNSProvider{
+		config:  config,
+		options: godaddy.NewOptions(config.APIKey, config.APISecret),
+	}, nil
+}
+
+// Present creates a TXT record to fulfill the DNS-01 challenge.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	fqdn, value := dns01.GetRecord(domain, keyAuth)
+
+	zone, err := dns01.FindZoneByFqdn(fqdn)
+	if err != nil {
+		return fmt.Errorf("acme: could not find zone for domain %q: %w", domain, err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(fqdn, zone)
+	if err != nil {
+		return fmt.Errorf("acme: %w", err)
+	}
+
+	records := godaddy.NewProductionAPI(d.options).V1().Domain(dns01.UnFqdn(zone)).Records()
+	record := godaddy.Record{Type: "TXT", Name: subDomain, Data: value, TTL: d.config.TTL}
+	if err := records.ReplaceByTypeName(context.Background(), "TXT", subDomain, []godaddy.Record{record}); err != nil {
+		return fmt.Errorf("acme: failed to create TXT record for %q: %w", fqdn, err)
+	}
+
+	return nil
+}
+
+// CleanUp removes the TXT record created by Present.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	fqdn, _ := dns01.GetRecord(domain, keyAuth)
+
+	zone, err := dns01.FindZoneByFqdn(fqdn)
+	if err != nil {
+		return fmt.Errorf("acme: could not find zone for domain %q: %w", domain, err)
+	}
+
+	subDomain, err := dns01.ExtractSubDomain(fqdn, zone)
+	if err != nil {
+		return fmt.Errorf("acme: %w", err)
+	}
+
+	records := godaddy.NewProductionAPI(d.options).V1().Domain(dns01.UnFqdn(zone)).Records()
+	if err := records.ReplaceByTypeName(context.Background(), "TXT", subDomain, []godaddy.Record{}); err != nil {
+		return fmt.Errorf("acme: failed to clean up TXT record for %q: %w", fqdn, err)
+	}
+
+	return nil
+}
+
+// Timeout returns the timeout and interval to use when checking for DNS
+// propagation.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
@@ -0,0 +1,31 @@
+// Package godaddy is a client for the GoDaddy Domains API.
+package godaddy
+
+// Base URLs for GoDaddy's two environments. OTE ("Operational Test
+// Environment") is GoDaddy's name for its development/sandbox API.
+const (
+	productionBaseURL  = "https://api.godaddy.com"
+	developmentBaseURL = "https://api.ote-godaddy.com"
+)
+
+// API is the entry point for a GoDaddy environment (production or
+// development), scoped to a single Options.
+type API struct {
+	baseURL string
+	options *Options
+}
+
+// NewProductionAPI targets the production GoDaddy API.
+func NewProductionAPI(options *Options) *API {
+	return &API{baseURL: productionBaseURL, options: options}
+}
+
+// NewDevelopmentAPI targets GoDaddy's OTE (development/sandbox) API.
+func NewDevelopmentAPI(options *Options) *API {
+	return &API{baseURL: developmentBaseURL, options: options}
+}
+
+// V1 targets version 1 of the API.
+func (a *API) V1() *V1 {
+	return &V1{baseURL: a.baseURL + "/v1", options: a.options}
+}
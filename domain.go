@@ -0,0 +1,41 @@
+package godaddy
+
+// V1 is version 1 of the GoDaddy Domains API.
+type V1 struct {
+	baseURL string
+	options *Options
+}
+
+// Domain targets the given domain name for subsequent calls, such as
+// Records(). Non-ASCII names are converted to punycode according to the
+// Options' IDNAMode; see Domain.Name to recover the original Unicode form.
+func (v *V1) Domain(name string) *Domain {
+	ascii, err := toASCII(v.options.idnaMode, name)
+	if err != nil {
+		// Strict mode rejected an invalid IDN. Keep going with the
+		// original name so it surfaces as a GoDaddy API error instead of
+		// failing silently here, where Domain has no error return.
+		ascii = name
+	}
+
+	return &Domain{name: ascii, unicodeName: name, baseURL: v.baseURL, options: v.options}
+}
+
+// Domain represents a single domain owned by the authenticated account.
+type Domain struct {
+	name        string
+	unicodeName string
+	baseURL     string
+	options     *Options
+}
+
+// Name returns the domain name in its original Unicode form, regardless of
+// the punycode form used internally to talk to GoDaddy.
+func (d *Domain) Name() string {
+	return d.unicodeName
+}
+
+// Records targets the DNS records of this domain.
+func (d *Domain) Records() *Records {
+	return &Records{domain: d.name, baseURL: d.baseURL, options: d.options}
+}
@@ -0,0 +1,33 @@
+package godaddy
+
+import godaddyhttp "github.com/oze4/godaddygo/pkg/http"
+
+// APIError is the typed error returned by an API call when GoDaddy answers
+// with a non-2xx status. Network failures and invalid input are returned as
+// other errors. See pkg/http.APIError for the field documentation.
+type APIError = godaddyhttp.APIError
+
+// FieldError describes a single field-level validation failure returned by
+// GoDaddy alongside an APIError.
+type FieldError = godaddyhttp.FieldError
+
+// Sentinel errors, re-exported from pkg/http so callers don't need to
+// import it directly to use errors.Is.
+var (
+	ErrUnauthorized  = godaddyhttp.ErrUnauthorized
+	ErrNotFound      = godaddyhttp.ErrNotFound
+	ErrRateLimited   = godaddyhttp.ErrRateLimited
+	ErrQuotaExceeded = godaddyhttp.ErrQuotaExceeded
+)
+
+// IsRateLimited reports whether err is, or wraps, a rate-limiting error.
+func IsRateLimited(err error) bool { return godaddyhttp.IsRateLimited(err) }
+
+// IsNotFound reports whether err is, or wraps, a not-found error.
+func IsNotFound(err error) bool { return godaddyhttp.IsNotFound(err) }
+
+// IsUnauthorized reports whether err is, or wraps, an authentication error.
+func IsUnauthorized(err error) bool { return godaddyhttp.IsUnauthorized(err) }
+
+// IsQuotaExceeded reports whether err is, or wraps, a quota error.
+func IsQuotaExceeded(err error) bool { return godaddyhttp.IsQuotaExceeded(err) }
@@ -0,0 +1,111 @@
+package godaddy
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// IDNAMode controls how internationalized domain names are handled when
+// talking to GoDaddy, which only accepts ASCII (punycode) labels.
+type IDNAMode int
+
+const (
+	// IDNALax converts non-ASCII names to punycode on a best-effort basis,
+	// falling back to the original name if conversion fails. This is the
+	// default.
+	IDNALax IDNAMode = iota
+	// IDNAStrict rejects names that are not valid IDNs instead of falling
+	// back: a Records call that sends such a record Name or Data returns an
+	// error. V1.Domain, which has no error return, does not reject an
+	// invalid domain name; it keeps the name exactly as given.
+	IDNAStrict
+)
+
+// dnsNameRecordTypes are the record types whose Data holds a DNS name
+// (rather than an IP, text, etc.) and therefore needs IDNA conversion.
+var dnsNameRecordTypes = map[string]bool{
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+}
+
+// isReservedLabel reports whether label is one of GoDaddy's own naming
+// conventions rather than a hostname label subject to IDNA rules: the
+// apex placeholder "@", and underscore-prefixed labels used by
+// TXT/SRV-based protocols (e.g. _acme-challenge, _dmarc, _sip._tcp).
+// idna.Lookup enforces IDNA2008's LDH rules, which reject both of these
+// outright even though they are perfectly ordinary, all-ASCII DNS labels.
+func isReservedLabel(label string) bool {
+	return label == "" || label == "@" || strings.HasPrefix(label, "_")
+}
+
+// toASCII converts name to its punycode form according to mode, leaving
+// reserved labels (see isReservedLabel) untouched.
+func toASCII(mode IDNAMode, name string) (string, error) {
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if isReservedLabel(label) {
+			continue
+		}
+
+		ascii, err := idna.Lookup.ToASCII(label)
+		if err != nil {
+			if mode == IDNAStrict {
+				return "", err
+			}
+			continue
+		}
+		labels[i] = ascii
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// toUnicode converts a punycode name back to its Unicode form, leaving
+// reserved labels (see isReservedLabel) untouched and any label that isn't
+// valid punycode as-is.
+func toUnicode(name string) string {
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if isReservedLabel(label) {
+			continue
+		}
+
+		unicode, err := idna.Lookup.ToUnicode(label)
+		if err != nil {
+			continue
+		}
+		labels[i] = unicode
+	}
+	return strings.Join(labels, ".")
+}
+
+// normalizeRecord converts rec's Name, and Data when it holds a DNS name,
+// to punycode before it is sent to GoDaddy.
+func normalizeRecord(mode IDNAMode, rec Record) (Record, error) {
+	name, err := toASCII(mode, rec.Name)
+	if err != nil {
+		return Record{}, err
+	}
+	rec.Name = name
+
+	if dnsNameRecordTypes[rec.Type] {
+		data, err := toASCII(mode, rec.Data)
+		if err != nil {
+			return Record{}, err
+		}
+		rec.Data = data
+	}
+
+	return rec, nil
+}
+
+// denormalizeRecord converts rec's Name, and Data when it holds a DNS name,
+// back to Unicode for the caller.
+func denormalizeRecord(rec Record) Record {
+	rec.Name = toUnicode(rec.Name)
+	if dnsNameRecordTypes[rec.Type] {
+		rec.Data = toUnicode(rec.Data)
+	}
+	return rec
+}
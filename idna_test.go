@@ -0,0 +1,48 @@
+package godaddy
+
+import "testing"
+
+func TestToASCIIConvertsUnicodeLabel(t *testing.T) {
+	ascii, err := toASCII(IDNALax, "bücher.example")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ascii != "xn--bcher-kva.example" {
+		t.Fatalf("expected punycode label, got %q", ascii)
+	}
+}
+
+func TestToASCIILeavesReservedLabelsAlone(t *testing.T) {
+	for _, name := range []string{"@", "_acme-challenge", "_dmarc", "_sip._tcp"} {
+		ascii, err := toASCII(IDNAStrict, name)
+		if err != nil {
+			t.Fatalf("%q: unexpected error in strict mode: %v", name, err)
+		}
+		if ascii != name {
+			t.Fatalf("%q: expected reserved label to pass through unchanged, got %q", name, ascii)
+		}
+	}
+}
+
+func TestToASCIIStrictRejectsInvalidIDN(t *testing.T) {
+	if _, err := toASCII(IDNAStrict, "exa mple"); err == nil {
+		t.Fatal("expected an error for an invalid IDN in strict mode")
+	}
+}
+
+func TestToASCIILaxFallsBackOnInvalidIDN(t *testing.T) {
+	ascii, err := toASCII(IDNALax, "exa mple")
+	if err != nil {
+		t.Fatalf("unexpected error in lax mode: %v", err)
+	}
+	if ascii != "exa mple" {
+		t.Fatalf("expected lax mode to fall back to the original label, got %q", ascii)
+	}
+}
+
+func TestToUnicodeRoundTrips(t *testing.T) {
+	unicode := toUnicode("xn--bcher-kva.example")
+	if unicode != "bücher.example" {
+		t.Fatalf("expected bücher.example, got %q", unicode)
+	}
+}
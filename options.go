@@ -0,0 +1,70 @@
+package godaddy
+
+import (
+	"net/http"
+	"time"
+
+	godaddyhttp "github.com/oze4/godaddygo/pkg/http"
+)
+
+// Options holds the credentials and transport settings used to build an API.
+type Options struct {
+	// APIKey and APISecret authenticate every request made with this
+	// Options. Note that the production and development API's have unique
+	// API keys/secrets.
+	APIKey    string
+	APISecret string
+
+	httpClient *http.Client
+	limiter    godaddyhttp.RateLimiter
+	idnaMode   IDNAMode
+}
+
+// NewOptions returns Options for the given API key/secret pair, using this
+// module's default transport (a single http.Client with retry/backoff on
+// rate limiting and transient failures, and no client-side rate limit).
+func NewOptions(apiKey, apiSecret string) *Options {
+	return &Options{
+		APIKey:    apiKey,
+		APISecret: apiSecret,
+	}
+}
+
+// WithHTTPClient overrides the *http.Client used to send requests. Useful
+// for setting custom timeouts, proxies or transports.
+func (o *Options) WithHTTPClient(client *http.Client) *Options {
+	o.httpClient = client
+	return o
+}
+
+// WithRateLimit caps outbound requests to qps per second, with bursts of up
+// to burst requests, so callers can stay under GoDaddy's rate limits.
+func (o *Options) WithRateLimit(qps float64, burst int) *Options {
+	o.limiter = godaddyhttp.NewTokenBucketLimiter(qps, burst)
+	return o
+}
+
+// WithIDNAMode controls how internationalized domain names are converted
+// to/from the ASCII (punycode) form GoDaddy requires. Defaults to IDNALax.
+func (o *Options) WithIDNAMode(mode IDNAMode) *Options {
+	o.idnaMode = mode
+	return o
+}
+
+// defaultHTTPClient is shared by every Options that doesn't set its own
+// client through WithHTTPClient.
+var defaultHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// doer builds the godaddyhttp.Doer used for every request made with these
+// Options.
+func (o *Options) doer() godaddyhttp.Doer {
+	client := o.httpClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+
+	return &godaddyhttp.RetryDoer{
+		Doer:    client,
+		Limiter: o.limiter,
+	}
+}
@@ -0,0 +1,188 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Doer is anything capable of executing an *http.Request and returning an
+// *http.Response, mirroring http.Client.Do. It is the extension point used
+// to inject retry, rate-limiting and custom transport behavior into Request.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// retryableMethods are the methods we consider safe to retry, since GoDaddy
+// treats them as idempotent.
+var retryableMethods = map[string]bool{
+	"GET":    true,
+	"PUT":    true,
+	"DELETE": true,
+}
+
+// RetryDoer wraps another Doer and retries on 429, 5xx and transient network
+// errors using exponential backoff with jitter, honoring any Retry-After
+// header the API sends back.
+type RetryDoer struct {
+	// Doer is the underlying Doer used to actually send requests. Defaults
+	// to http.DefaultClient if nil.
+	Doer Doer
+	// Limiter, if set, is consulted before every attempt so callers can cap
+	// outbound QPS client-side.
+	Limiter RateLimiter
+
+	// MaxRetries is the number of attempts made in addition to the first
+	// one. Defaults to 4 when zero.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the exponential backoff delay used
+	// between retries, before jitter is applied. Default to 500ms and 30s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// NewRetryDoer returns a RetryDoer with the given underlying Doer and
+// this package's default retry/backoff settings.
+func NewRetryDoer(doer Doer) *RetryDoer {
+	return &RetryDoer{
+		Doer:       doer,
+		MaxRetries: 4,
+		MinBackoff: 500 * time.Millisecond,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// Do sends req, retrying on rate limiting, server errors and transient
+// network failures.
+func (d *RetryDoer) Do(req *http.Request) (*http.Response, error) {
+	doer := d.Doer
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+
+	maxRetries := d.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 4
+	}
+
+	// Buffer the body once so that every attempt, not only the first, sends
+	// it in full.
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		if d.Limiter != nil {
+			if err := d.Limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := doer.Do(req)
+		if err == nil && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			return resp, nil
+		}
+
+		if !retryableMethods[req.Method] {
+			if err == nil {
+				return resp, nil
+			}
+			return nil, err
+		}
+
+		if attempt == maxRetries {
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		delay := d.backoff(attempt)
+		if err == nil {
+			if ra, ok := retryAfter(resp); ok {
+				delay = ra
+			}
+			resp.Body.Close()
+		}
+		lastErr = err
+
+		if !sleep(req.Context(), delay) {
+			if lastErr != nil {
+				return nil, lastErr
+			}
+			return nil, req.Context().Err()
+		}
+	}
+
+	return nil, lastErr
+}
+
+// backoff returns the exponential backoff delay for the given attempt
+// (0-indexed), with full jitter applied.
+func (d *RetryDoer) backoff(attempt int) time.Duration {
+	min := d.MinBackoff
+	if min == 0 {
+		min = 500 * time.Millisecond
+	}
+	max := d.MaxBackoff
+	if max == 0 {
+		max = 30 * time.Second
+	}
+
+	delay := min << uint(attempt)
+	if delay <= 0 || delay > max {
+		delay = max
+	}
+
+	// Full jitter: a random duration in [0, delay).
+	return time.Duration(rand.Int63n(int64(delay)))
+}
+
+// retryAfter parses the Retry-After header of resp, supporting both the
+// delay-seconds and HTTP-date forms.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	h := resp.Header.Get("Retry-After")
+	if h == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(h); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(h); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d, true
+		}
+	}
+
+	return 0, false
+}
+
+// sleep waits for d, or until ctx is cancelled, returning false in the
+// latter case.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryDoerRetriesOnRateLimit(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	doer := NewRetryDoer(server.Client())
+	req, err := http.NewRequest("GET", server.URL, nil)
+	if err != nil {
+		t.Fatalf("unexpected error building request: %v", err)
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts, got %d", attempts)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected final status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestRetryDoerDoesNotRetryNonIdempotentMethods(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	doer := NewRetryDoer(server.Client())
+	req, err := http.NewRequest("POST", server.URL, nil)
+	if err != nil {
+		t.Fatalf("unexpected error building request: %v", err)
+	}
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if attempts != 1 {
+		t.Fatalf("expected POST not to be retried, got %d attempts", attempts)
+	}
+}
+
+type errDoer struct {
+	calls int
+	err   error
+}
+
+func (d *errDoer) Do(req *http.Request) (*http.Response, error) {
+	d.calls++
+	return nil, d.err
+}
+
+func TestRetryDoerDoesNotRetryNonIdempotentMethodsOnNetworkError(t *testing.T) {
+	wantErr := errors.New("connection reset")
+	inner := &errDoer{err: wantErr}
+	doer := NewRetryDoer(inner)
+
+	req, err := http.NewRequest("POST", "http://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("unexpected error building request: %v", err)
+	}
+
+	_, err = doer.Do(req)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected the underlying network error, got %v", err)
+	}
+	if inner.calls != 1 {
+		t.Fatalf("expected POST not to be retried on a network error, got %d attempts", inner.calls)
+	}
+}
+
+func TestRetryDoerRetriesIdempotentMethodsOnNetworkError(t *testing.T) {
+	wantErr := errors.New("connection reset")
+	inner := &errDoer{err: wantErr}
+	doer := NewRetryDoer(inner)
+	doer.MinBackoff = time.Millisecond
+	doer.MaxBackoff = time.Millisecond
+	doer.MaxRetries = 2
+
+	req, err := http.NewRequest("GET", "http://example.invalid", nil)
+	if err != nil {
+		t.Fatalf("unexpected error building request: %v", err)
+	}
+
+	_, err = doer.Do(req)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected the underlying network error, got %v", err)
+	}
+	if inner.calls != 3 {
+		t.Fatalf("expected 3 attempts (1 + 2 retries), got %d", inner.calls)
+	}
+}
+
+func TestRetryAfterParsesDelaySeconds(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+
+	d, ok := retryAfter(resp)
+	if !ok {
+		t.Fatal("expected Retry-After to be parsed")
+	}
+	if d != 2*time.Second {
+		t.Fatalf("expected 2s, got %s", d)
+	}
+}
+
+func TestRetryAfterMissingHeader(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+
+	if _, ok := retryAfter(resp); ok {
+		t.Fatal("expected no Retry-After to be found")
+	}
+}
+
+func TestBackoffStaysWithinBounds(t *testing.T) {
+	d := &RetryDoer{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		delay := d.backoff(attempt)
+		if delay < 0 || delay > d.MaxBackoff {
+			t.Fatalf("attempt %d: backoff %s out of bounds [0, %s]", attempt, delay, d.MaxBackoff)
+		}
+	}
+}
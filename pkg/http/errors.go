@@ -0,0 +1,103 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors callers can check against with errors.Is, regardless of
+// the exact message GoDaddy returned.
+var (
+	ErrUnauthorized  = errors.New("godaddy: unauthorized")
+	ErrNotFound      = errors.New("godaddy: not found")
+	ErrRateLimited   = errors.New("godaddy: rate limited")
+	ErrQuotaExceeded = errors.New("godaddy: quota exceeded")
+)
+
+// codeSentinels maps the `code` field GoDaddy returns to one of the
+// sentinel errors above.
+var codeSentinels = map[string]error{
+	"ACCESS_DENIED":          ErrUnauthorized,
+	"UNABLE_TO_AUTHENTICATE": ErrUnauthorized,
+	"NOT_FOUND":              ErrNotFound,
+	"UNKNOWN_DOMAIN":         ErrNotFound,
+	"TOO_MANY_REQUESTS":      ErrRateLimited,
+	"QUOTA_EXCEEDED":         ErrQuotaExceeded,
+}
+
+// FieldError describes a single field-level validation failure, as returned
+// by GoDaddy in the `fields` array of an error response.
+type FieldError struct {
+	Path        string `json:"path"`
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	PathRelated string `json:"pathRelated"`
+}
+
+// APIError is the typed form of the error envelope GoDaddy's API returns:
+//
+//	{"code": "...", "message": "...", "fields": [{"path": "...", ...}]}
+type APIError struct {
+	// HTTPStatus is the HTTP status code the response was sent with.
+	HTTPStatus int
+	Code       string       `json:"code"`
+	Message    string       `json:"message"`
+	Fields     []FieldError `json:"fields"`
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if len(e.Fields) == 0 {
+		return fmt.Sprintf("godaddy: %d %s: %s", e.HTTPStatus, e.Code, e.Message)
+	}
+	return fmt.Sprintf("godaddy: %d %s: %s (%d field error(s), first: %s %s)",
+		e.HTTPStatus, e.Code, e.Message, len(e.Fields), e.Fields[0].Path, e.Fields[0].Message)
+}
+
+// Unwrap exposes the sentinel error matching e.Code or e.HTTPStatus, if any,
+// so callers can use errors.Is(err, http.ErrNotFound) and similar.
+func (e *APIError) Unwrap() error {
+	if sentinel, ok := codeSentinels[e.Code]; ok {
+		return sentinel
+	}
+	switch e.HTTPStatus {
+	case 401, 403:
+		return ErrUnauthorized
+	case 404:
+		return ErrNotFound
+	case 429:
+		return ErrRateLimited
+	default:
+		return nil
+	}
+}
+
+// parseAPIError decodes bodyBytes as GoDaddy's error envelope. If bodyBytes
+// isn't valid JSON, Code and Message are left empty but HTTPStatus is still
+// populated so callers retain at least the status code.
+func parseAPIError(status int, bodyBytes []byte) *APIError {
+	apiErr := &APIError{HTTPStatus: status}
+	_ = json.Unmarshal(bodyBytes, apiErr)
+	return apiErr
+}
+
+// IsRateLimited reports whether err is, or wraps, a rate-limiting error.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsNotFound reports whether err is, or wraps, a not-found error.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnauthorized reports whether err is, or wraps, an authentication error.
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsQuotaExceeded reports whether err is, or wraps, a quota error.
+func IsQuotaExceeded(err error) bool {
+	return errors.Is(err, ErrQuotaExceeded)
+}
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAPIErrorDecodesFields(t *testing.T) {
+	body := []byte(`{
+		"code": "VALIDATION_FAILED",
+		"message": "Invalid value",
+		"fields": [{"path": "name", "code": "INVALID", "message": "bad name"}]
+	}`)
+
+	apiErr := parseAPIError(422, body)
+
+	if apiErr.HTTPStatus != 422 {
+		t.Fatalf("expected HTTPStatus 422, got %d", apiErr.HTTPStatus)
+	}
+	if apiErr.Code != "VALIDATION_FAILED" {
+		t.Fatalf("expected code VALIDATION_FAILED, got %q", apiErr.Code)
+	}
+	if len(apiErr.Fields) != 1 || apiErr.Fields[0].Path != "name" {
+		t.Fatalf("expected one field error for path %q, got %+v", "name", apiErr.Fields)
+	}
+}
+
+func TestAPIErrorSentinelsByCode(t *testing.T) {
+	tests := []struct {
+		code    string
+		status  int
+		wantErr error
+	}{
+		{code: "UNKNOWN_DOMAIN", status: 404, wantErr: ErrNotFound},
+		{code: "ACCESS_DENIED", status: 403, wantErr: ErrUnauthorized},
+		{code: "", status: 404, wantErr: ErrNotFound},
+		{code: "", status: 401, wantErr: ErrUnauthorized},
+		{code: "", status: 429, wantErr: ErrRateLimited},
+		{code: "QUOTA_EXCEEDED", status: 409, wantErr: ErrQuotaExceeded},
+	}
+
+	for _, tt := range tests {
+		apiErr := &APIError{Code: tt.code, HTTPStatus: tt.status}
+		if !errors.Is(apiErr, tt.wantErr) {
+			t.Errorf("code=%q status=%d: expected errors.Is to match %v", tt.code, tt.status, tt.wantErr)
+		}
+	}
+}
+
+func TestIsHelpers(t *testing.T) {
+	err := &APIError{Code: "TOO_MANY_REQUESTS", HTTPStatus: 429}
+
+	if !IsRateLimited(err) {
+		t.Fatal("expected IsRateLimited to be true")
+	}
+	if IsNotFound(err) {
+		t.Fatal("expected IsNotFound to be false")
+	}
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter caps how often requests are allowed to go out, so callers can
+// stay under GoDaddy's per-second and per-minute API limits.
+type RateLimiter interface {
+	// Wait blocks until a request is allowed to proceed, or ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// TokenBucketLimiter is a RateLimiter backed by golang.org/x/time/rate.
+type TokenBucketLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewTokenBucketLimiter returns a RateLimiter that allows qps requests per
+// second on average, with bursts of up to burst requests.
+func NewTokenBucketLimiter(qps float64, burst int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		limiter: rate.NewLimiter(rate.Limit(qps), burst),
+	}
+}
+
+// Wait blocks until the token bucket has capacity for another request.
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
@@ -0,0 +1,23 @@
+package http
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketLimiterCapsBurst(t *testing.T) {
+	limiter := NewTokenBucketLimiter(1, 1)
+	ctx := context.Background()
+
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error on first request: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("expected second immediate request to be throttled past the deadline")
+	}
+}
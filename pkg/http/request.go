@@ -1,11 +1,12 @@
 package http
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"strings"
 
@@ -35,8 +36,22 @@ type Request struct {
 	Host string
 	// The body of your request, if you need one
 	Body []byte
+	// Context, if set, governs cancellation/deadlines for the request and
+	// its retries. Defaults to context.Background() when nil.
+	Context context.Context
+	// Doer sends the built *http.Request. Defaults to DefaultDoer when nil,
+	// which reuses a single *http.Client and retries on rate limiting and
+	// transient failures. Callers that need a custom *http.Client or a
+	// client-side rate limit should set this instead of relying on the
+	// default.
+	Doer Doer
 }
 
+// DefaultDoer is used by Request.Do whenever Doer is left nil. It is a
+// package-level variable so callers can override the default globally
+// instead of setting Doer on every Request.
+var DefaultDoer Doer = NewRetryDoer(&http.Client{Timeout: 30 * time.Second})
+
 // Do sends the http request
 func (r *Request) Do() ([]byte, error) {
 	// Verify we were given a valid REST method
@@ -51,8 +66,13 @@ func (r *Request) Do() ([]byte, error) {
 		bodyFin = ioutil.NopCloser(strings.NewReader(string(r.Body)))
 	}
 
+	ctx := r.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	// Create new REST request
-	req, err := http.NewRequest(r.Method, r.URL, bodyFin)
+	req, err := http.NewRequestWithContext(ctx, r.Method, r.URL, bodyFin)
 	if err != nil {
 		return nil, err
 	}
@@ -60,11 +80,13 @@ func (r *Request) Do() ([]byte, error) {
 	// Add authorization to our request
 	req.Header.Set("Authorization", r.makeAuthString())
 
-	// Create new http client to send our request
-	httpclient := &http.Client{}
+	doer := r.Doer
+	if doer == nil {
+		doer = DefaultDoer
+	}
 
 	// Send request, check for error
-	resp, err := httpclient.Do(req)
+	resp, err := doer.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -95,13 +117,7 @@ func (r *Request) makeAuthString() string {
 // verifyStatusCode ensure we got a good response
 func (r *Request) verifyStatusCode(resp *http.Response, bodyBytes []byte) error {
 	if resp.StatusCode <= 199 || resp.StatusCode >= 300 {
-		var respMap map[string]string
-		_ = json.Unmarshal(bodyBytes, &respMap)
-		var status []string
-		for k, v := range respMap {
-			status = append(status, k + ":" + v)
-		}
-		return errors.New(strings.Join(status, ","))
+		return parseAPIError(resp.StatusCode, bodyBytes)
 	}
 	return nil
-} 
+}
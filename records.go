@@ -0,0 +1,167 @@
+package godaddy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	godaddyhttp "github.com/oze4/godaddygo/pkg/http"
+)
+
+// Record is a single DNS record, as accepted/returned by GoDaddy's records
+// endpoints.
+type Record struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Data     string `json:"data"`
+	TTL      int    `json:"ttl,omitempty"`
+	Priority int    `json:"priority,omitempty"`
+	Weight   int    `json:"weight,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Flags    int    `json:"flags,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+}
+
+// Records targets the DNS records of a single Domain.
+type Records struct {
+	domain  string
+	baseURL string
+	options *Options
+}
+
+// Get returns every record currently configured on the domain.
+func (r *Records) Get() ([]Record, error) {
+	return r.get(context.Background(), "/records")
+}
+
+// SetValue creates or updates a single record of the given type/name with
+// data.
+func (r *Records) SetValue(recordType, name, data string) error {
+	return r.ReplaceByTypeName(context.Background(), recordType, name, []Record{
+		{Type: recordType, Name: name, Data: data},
+	})
+}
+
+// Replace mirrors the full, ordered list of desired records onto the
+// domain via a single PUT, deleting anything not present in records.
+func (r *Records) Replace(ctx context.Context, records []Record) error {
+	return r.put(ctx, "/records", records)
+}
+
+// ReplaceByType mirrors records onto every record of the given type on the
+// domain, leaving other record types untouched.
+func (r *Records) ReplaceByType(ctx context.Context, recordType string, records []Record) error {
+	return r.put(ctx, "/records/"+recordType, records)
+}
+
+// ReplaceByTypeName mirrors records onto the given type/name pair, leaving
+// every other record on the domain untouched. GoDaddy requires PUT rather
+// than PATCH here: PATCH semantics on array-valued records are broken on
+// GoDaddy's side.
+func (r *Records) ReplaceByTypeName(ctx context.Context, recordType, name string, records []Record) error {
+	return r.put(ctx, "/records/"+recordType+"/"+name, records)
+}
+
+// Diff fetches the domain's current records and compares them against
+// desired, returning the records that need to be added and removed to
+// reconcile the domain to that desired state. It does not modify anything;
+// pair it with Replace/ReplaceByType/ReplaceByTypeName to apply the result.
+func (r *Records) Diff(ctx context.Context, desired []Record) (add, remove []Record, err error) {
+	current, err := r.get(ctx, "/records")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	add, remove = diffRecords(current, desired)
+	return add, remove, nil
+}
+
+// diffRecords compares current against desired and returns the records
+// that would need to be added and removed to turn one into the other.
+func diffRecords(current, desired []Record) (add, remove []Record) {
+	currentByKey := make(map[string]Record, len(current))
+	for _, rec := range current {
+		currentByKey[recordKey(rec)] = rec
+	}
+
+	desiredByKey := make(map[string]Record, len(desired))
+	for _, rec := range desired {
+		desiredByKey[recordKey(rec)] = rec
+	}
+
+	for key, rec := range desiredByKey {
+		if _, ok := currentByKey[key]; !ok {
+			add = append(add, rec)
+		}
+	}
+	for key, rec := range currentByKey {
+		if _, ok := desiredByKey[key]; !ok {
+			remove = append(remove, rec)
+		}
+	}
+
+	return add, remove
+}
+
+// recordKey identifies a record by every field that reconciliation cares
+// about, so that e.g. an MX/SRV priority change or a TTL change is treated
+// as a difference rather than silently ignored.
+func recordKey(r Record) string {
+	return fmt.Sprintf("%s|%s|%s|%d|%d|%d|%d|%d|%s", r.Type, r.Name, r.Data, r.TTL, r.Priority, r.Weight, r.Port, r.Flags, r.Tag)
+}
+
+func (r *Records) get(ctx context.Context, path string) ([]Record, error) {
+	req := &godaddyhttp.Request{
+		APIKey:    r.options.APIKey,
+		APISecret: r.options.APISecret,
+		Method:    "GET",
+		URL:       r.baseURL + "/domains/" + r.domain + path,
+		Context:   ctx,
+		Doer:      r.options.doer(),
+	}
+
+	body, err := req.Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	if err := json.Unmarshal(body, &records); err != nil {
+		return nil, err
+	}
+
+	for i, rec := range records {
+		records[i] = denormalizeRecord(rec)
+	}
+
+	return records, nil
+}
+
+func (r *Records) put(ctx context.Context, path string, records []Record) error {
+	normalized := make([]Record, len(records))
+	for i, rec := range records {
+		n, err := normalizeRecord(r.options.idnaMode, rec)
+		if err != nil {
+			return fmt.Errorf("godaddy: invalid IDN in record %+v: %w", rec, err)
+		}
+		normalized[i] = n
+	}
+
+	body, err := json.Marshal(normalized)
+	if err != nil {
+		return err
+	}
+
+	req := &godaddyhttp.Request{
+		APIKey:    r.options.APIKey,
+		APISecret: r.options.APISecret,
+		Method:    "PUT",
+		URL:       r.baseURL + "/domains/" + r.domain + path,
+		Body:      body,
+		Context:   ctx,
+		Doer:      r.options.doer(),
+	}
+
+	_, err = req.Do()
+	return err
+}
@@ -0,0 +1,51 @@
+package godaddy
+
+import "testing"
+
+func TestDiffRecordsAddAndRemove(t *testing.T) {
+	current := []Record{
+		{Type: "A", Name: "www", Data: "1.1.1.1"},
+	}
+	desired := []Record{
+		{Type: "A", Name: "www", Data: "2.2.2.2"},
+	}
+
+	add, remove := diffRecords(current, desired)
+
+	if len(add) != 1 || add[0].Data != "2.2.2.2" {
+		t.Fatalf("expected to add 2.2.2.2, got %+v", add)
+	}
+	if len(remove) != 1 || remove[0].Data != "1.1.1.1" {
+		t.Fatalf("expected to remove 1.1.1.1, got %+v", remove)
+	}
+}
+
+func TestDiffRecordsNoChange(t *testing.T) {
+	records := []Record{
+		{Type: "TXT", Name: "@", Data: "hello"},
+	}
+
+	add, remove := diffRecords(records, records)
+
+	if len(add) != 0 || len(remove) != 0 {
+		t.Fatalf("expected no diff, got add=%+v remove=%+v", add, remove)
+	}
+}
+
+func TestDiffRecordsDetectsPriorityChange(t *testing.T) {
+	current := []Record{
+		{Type: "MX", Name: "@", Data: "mail.example.com", Priority: 10},
+	}
+	desired := []Record{
+		{Type: "MX", Name: "@", Data: "mail.example.com", Priority: 20},
+	}
+
+	add, remove := diffRecords(current, desired)
+
+	if len(add) != 1 || add[0].Priority != 20 {
+		t.Fatalf("expected to add the new priority, got %+v", add)
+	}
+	if len(remove) != 1 || remove[0].Priority != 10 {
+		t.Fatalf("expected to remove the old priority, got %+v", remove)
+	}
+}
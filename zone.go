@@ -0,0 +1,265 @@
+package godaddy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// ImportMode controls how ImportZone reconciles a zone file against a
+// domain's existing records.
+type ImportMode int
+
+const (
+	// ImportMerge upserts every record found in the zone file, leaving any
+	// existing record not present in the file untouched.
+	ImportMerge ImportMode = iota
+	// ImportReplaceAll mirrors the zone file exactly, deleting anything on
+	// the domain not present in it.
+	ImportReplaceAll
+	// ImportDryRun computes the diff that would be applied without making
+	// any changes.
+	ImportDryRun
+)
+
+// ExportZone returns the domain's records serialized as a standard
+// BIND-format (RFC 1035) zone file.
+func (r *Records) ExportZone(ctx context.Context) ([]byte, error) {
+	records, err := r.get(ctx, "/records")
+	if err != nil {
+		return nil, err
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "$ORIGIN %s\n", dns.Fqdn(r.domain))
+
+	for _, rec := range records {
+		// get() hands back Name/Data in their Unicode form; zone files are
+		// ASCII/punycode, so convert back before printing.
+		ascii, err := normalizeRecord(r.options.idnaMode, rec)
+		if err != nil {
+			return nil, fmt.Errorf("godaddy: invalid IDN in record %+v: %w", rec, err)
+		}
+
+		rr, supported := recordToRR(r.domain, ascii)
+		if !supported {
+			// Mirrors parseZone: skip record types this module doesn't
+			// yet translate instead of failing the whole export.
+			continue
+		}
+		buf.WriteString(rr.String())
+		buf.WriteByte('\n')
+	}
+
+	return []byte(buf.String()), nil
+}
+
+// ImportZone reads a BIND-format (RFC 1035) zone file from zone and
+// reconciles the domain's records against it according to mode. It returns
+// the records that were (or, for ImportDryRun, would be) added and removed;
+// ImportMerge never deletes anything, so its remove is always nil.
+func (r *Records) ImportZone(ctx context.Context, zone io.Reader, mode ImportMode) (add, remove []Record, err error) {
+	desired, err := parseZone(zone, r.domain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := r.get(ctx, "/records")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// current comes back from get() denormalized to Unicode, but desired
+	// is still in the zone file's ASCII/punycode form; diff them in the
+	// same representation or every non-ASCII record looks changed.
+	desiredUnicode := make([]Record, len(desired))
+	for i, rec := range desired {
+		desiredUnicode[i] = denormalizeRecord(rec)
+	}
+
+	add, remove = diffRecords(current, desiredUnicode)
+
+	switch mode {
+	case ImportDryRun:
+		return add, remove, nil
+
+	case ImportReplaceAll:
+		if err := r.Replace(ctx, desired); err != nil {
+			return add, remove, err
+		}
+		return add, remove, nil
+
+	default: // ImportMerge
+		// Group current in desired's ASCII/punycode form too, so that a
+		// non-ASCII type/name pair finds its existing records.
+		currentASCII := make([]Record, len(current))
+		for i, rec := range current {
+			n, err := normalizeRecord(r.options.idnaMode, rec)
+			if err != nil {
+				return add, nil, fmt.Errorf("godaddy: invalid IDN in record %+v: %w", rec, err)
+			}
+			currentASCII[i] = n
+		}
+		currentByTypeName := groupByTypeName(currentASCII)
+		for key, recs := range groupByTypeName(desired) {
+			merged := unionRecords(currentByTypeName[key], recs)
+			if err := r.ReplaceByTypeName(ctx, key.Type, key.Name, merged); err != nil {
+				return add, remove, err
+			}
+		}
+		// Merge only upserts; unlike ImportReplaceAll, nothing is ever
+		// deleted, so the full-mirror diff's remove list would be a lie.
+		return add, nil, nil
+	}
+}
+
+// unionRecords combines existing and incoming, keyed by Data, so that
+// ImportMerge upserts incoming records without dropping existing ones of
+// the same type/name it doesn't mention (e.g. the other half of a
+// round-robin A record). incoming wins on a Data collision.
+func unionRecords(existing, incoming []Record) []Record {
+	byData := make(map[string]Record, len(existing)+len(incoming))
+	for _, rec := range existing {
+		byData[rec.Data] = rec
+	}
+	for _, rec := range incoming {
+		byData[rec.Data] = rec
+	}
+
+	merged := make([]Record, 0, len(byData))
+	for _, rec := range byData {
+		merged = append(merged, rec)
+	}
+	return merged
+}
+
+// typeName groups records by the type/name pair GoDaddy's per-name replace
+// endpoint targets.
+type typeName struct {
+	Type string
+	Name string
+}
+
+func groupByTypeName(records []Record) map[typeName][]Record {
+	groups := make(map[typeName][]Record)
+	for _, rec := range records {
+		key := typeName{Type: rec.Type, Name: rec.Name}
+		groups[key] = append(groups[key], rec)
+	}
+	return groups
+}
+
+// parseZone parses zone as a BIND-format zone file rooted at origin and
+// returns its records in GoDaddy's Record form. RR types GoDaddy doesn't
+// support (or that this module doesn't yet translate) are skipped.
+func parseZone(zone io.Reader, origin string) ([]Record, error) {
+	var records []Record
+
+	zp := dns.NewZoneParser(zone, dns.Fqdn(origin), "")
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		rec, supported := recordFromRR(origin, rr)
+		if !supported {
+			continue
+		}
+		records = append(records, rec)
+	}
+	if err := zp.Err(); err != nil {
+		return nil, fmt.Errorf("godaddy: parsing zone file: %w", err)
+	}
+
+	return records, nil
+}
+
+// recordToRR converts rec into a dns.RR suitable for printing in a zone
+// file rooted at origin. The second return value is false for record types
+// this module doesn't translate, mirroring recordFromRR.
+func recordToRR(origin string, rec Record) (dns.RR, bool) {
+	ttl := uint32(rec.TTL)
+	if ttl == 0 {
+		ttl = 600
+	}
+
+	hdr := dns.RR_Header{
+		Name:   dns.Fqdn(recordFQDN(origin, rec.Name)),
+		Rrtype: dns.StringToType[rec.Type],
+		Class:  dns.ClassINET,
+		Ttl:    ttl,
+	}
+
+	switch rec.Type {
+	case "A":
+		return &dns.A{Hdr: hdr, A: net.ParseIP(rec.Data)}, true
+	case "AAAA":
+		return &dns.AAAA{Hdr: hdr, AAAA: net.ParseIP(rec.Data)}, true
+	case "CNAME":
+		return &dns.CNAME{Hdr: hdr, Target: dns.Fqdn(rec.Data)}, true
+	case "NS":
+		return &dns.NS{Hdr: hdr, Ns: dns.Fqdn(rec.Data)}, true
+	case "MX":
+		return &dns.MX{Hdr: hdr, Preference: uint16(rec.Priority), Mx: dns.Fqdn(rec.Data)}, true
+	case "TXT":
+		return &dns.TXT{Hdr: hdr, Txt: []string{rec.Data}}, true
+	case "SRV":
+		return &dns.SRV{Hdr: hdr, Priority: uint16(rec.Priority), Weight: uint16(rec.Weight), Port: uint16(rec.Port), Target: dns.Fqdn(rec.Data)}, true
+	case "CAA":
+		return &dns.CAA{Hdr: hdr, Flag: uint8(rec.Flags), Tag: rec.Tag, Value: rec.Data}, true
+	default:
+		return nil, false
+	}
+}
+
+// recordFromRR converts rr into GoDaddy's Record form, relative to origin.
+// The second return value is false for RR types this module doesn't
+// translate, such as SOA.
+func recordFromRR(origin string, rr dns.RR) (Record, bool) {
+	hdr := rr.Header()
+	name := relativeName(origin, hdr.Name)
+	ttl := int(hdr.Ttl)
+
+	switch v := rr.(type) {
+	case *dns.A:
+		return Record{Type: "A", Name: name, Data: v.A.String(), TTL: ttl}, true
+	case *dns.AAAA:
+		return Record{Type: "AAAA", Name: name, Data: v.AAAA.String(), TTL: ttl}, true
+	case *dns.CNAME:
+		return Record{Type: "CNAME", Name: name, Data: strings.TrimSuffix(v.Target, "."), TTL: ttl}, true
+	case *dns.NS:
+		return Record{Type: "NS", Name: name, Data: strings.TrimSuffix(v.Ns, "."), TTL: ttl}, true
+	case *dns.MX:
+		return Record{Type: "MX", Name: name, Data: strings.TrimSuffix(v.Mx, "."), Priority: int(v.Preference), TTL: ttl}, true
+	case *dns.TXT:
+		return Record{Type: "TXT", Name: name, Data: strings.Join(v.Txt, ""), TTL: ttl}, true
+	case *dns.SRV:
+		return Record{
+			Type: "SRV", Name: name, Data: strings.TrimSuffix(v.Target, "."),
+			Priority: int(v.Priority), Weight: int(v.Weight), Port: int(v.Port), TTL: ttl,
+		}, true
+	case *dns.CAA:
+		return Record{Type: "CAA", Name: name, Data: v.Value, Flags: int(v.Flag), Tag: v.Tag, TTL: ttl}, true
+	default:
+		return Record{}, false
+	}
+}
+
+// recordFQDN joins a GoDaddy-relative record name ("@" for the apex) with
+// origin to build the absolute name a zone file expects.
+func recordFQDN(origin, name string) string {
+	if name == "" || name == "@" {
+		return origin
+	}
+	return name + "." + origin
+}
+
+// relativeName strips origin from an absolute zone-file name, returning
+// "@" for the apex, mirroring GoDaddy's own record naming convention.
+func relativeName(origin, name string) string {
+	originFQDN := dns.Fqdn(origin)
+	if name == originFQDN {
+		return "@"
+	}
+	return strings.TrimSuffix(name, "."+originFQDN)
+}
@@ -0,0 +1,115 @@
+package godaddy
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseZoneProducesRecords(t *testing.T) {
+	zone := `$ORIGIN example.com.
+www	600	IN	A	1.2.3.4
+@	3600	IN	MX	10 mail.example.com.
+`
+	records, err := parseZone(strings.NewReader(zone), "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d: %+v", len(records), records)
+	}
+
+	if records[0].Type != "A" || records[0].Name != "www" || records[0].Data != "1.2.3.4" {
+		t.Fatalf("unexpected first record: %+v", records[0])
+	}
+	if records[1].Type != "MX" || records[1].Name != "@" || records[1].Priority != 10 {
+		t.Fatalf("unexpected second record: %+v", records[1])
+	}
+}
+
+func TestRecordToRRAndBackRoundTrips(t *testing.T) {
+	rec := Record{Type: "CNAME", Name: "www", Data: "example.com", TTL: 600}
+
+	rr, ok := recordToRR("example.com", rec)
+	if !ok {
+		t.Fatal("expected CNAME to be a supported record type")
+	}
+
+	got, ok := recordFromRR("example.com", rr)
+	if !ok {
+		t.Fatal("expected CNAME to be a supported record type")
+	}
+	if got.Name != rec.Name || got.Data != rec.Data || got.Type != rec.Type {
+		t.Fatalf("round trip mismatch: got %+v, want %+v", got, rec)
+	}
+}
+
+func TestRecordToRRAndBackRoundTripsCAA(t *testing.T) {
+	rec := Record{Type: "CAA", Name: "@", Data: "letsencrypt.org", Flags: 0, Tag: "issue", TTL: 3600}
+
+	rr, ok := recordToRR("example.com", rec)
+	if !ok {
+		t.Fatal("expected CAA to be a supported record type")
+	}
+
+	got, ok := recordFromRR("example.com", rr)
+	if !ok {
+		t.Fatal("expected CAA to be a supported record type")
+	}
+	if got.Name != rec.Name || got.Data != rec.Data || got.Flags != rec.Flags || got.Tag != rec.Tag {
+		t.Fatalf("round trip mismatch: got %+v, want %+v", got, rec)
+	}
+}
+
+func TestRecordToRRSkipsUnsupportedType(t *testing.T) {
+	if _, ok := recordToRR("example.com", Record{Type: "SOA", Name: "@", Data: "ns1.example.com"}); ok {
+		t.Fatal("expected SOA to be reported as unsupported")
+	}
+}
+
+func TestRelativeNameAndFQDN(t *testing.T) {
+	if got := relativeName("example.com", "example.com."); got != "@" {
+		t.Fatalf("expected @ for the apex, got %q", got)
+	}
+	if got := relativeName("example.com", "www.example.com."); got != "www" {
+		t.Fatalf("expected www, got %q", got)
+	}
+	if got := recordFQDN("example.com", "@"); got != "example.com" {
+		t.Fatalf("expected example.com for the apex, got %q", got)
+	}
+	if got := recordFQDN("example.com", "www"); got != "www.example.com" {
+		t.Fatalf("expected www.example.com, got %q", got)
+	}
+}
+
+func TestUnionRecordsUpsertsWithoutDroppingExisting(t *testing.T) {
+	existing := []Record{
+		{Type: "A", Name: "www", Data: "1.1.1.1"},
+		{Type: "A", Name: "www", Data: "2.2.2.2"},
+	}
+	incoming := []Record{
+		{Type: "A", Name: "www", Data: "1.1.1.1", TTL: 3600},
+	}
+
+	merged := unionRecords(existing, incoming)
+
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 records after union, got %d: %+v", len(merged), merged)
+	}
+
+	var sawUpdated, sawUntouched bool
+	for _, rec := range merged {
+		switch rec.Data {
+		case "1.1.1.1":
+			sawUpdated = rec.TTL == 3600
+		case "2.2.2.2":
+			sawUntouched = true
+		}
+	}
+	if !sawUpdated {
+		t.Fatal("expected the incoming record to win on a Data collision")
+	}
+	if !sawUntouched {
+		t.Fatal("expected the untouched existing record to survive the merge")
+	}
+}